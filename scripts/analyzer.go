@@ -0,0 +1,184 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// WordFreq is one word's entry in a ranked frequency table. JSON tags match
+// the "rank"/"word"/"count" column names CSVReporter.RenderWordFrequencies
+// and MarkdownReporter already use.
+type WordFreq struct {
+	Word  string `json:"word"`
+	Count int    `json:"count"`
+	Rank  int    `json:"rank"`
+}
+
+// CaseFolding controls whether Analyzer lowercases words before counting.
+type CaseFolding int
+
+const (
+	FoldLower CaseFolding = iota
+	FoldNone
+)
+
+// Analyzer turns a raw word-frequency map into a filtered, ranked
+// []WordFreq according to a configurable stopword/length/case/stemming
+// policy. Build one with NewAnalyzer and the With* options.
+type Analyzer struct {
+	stopwords  map[string]bool
+	minRuneLen int
+	fold       CaseFolding
+	stem       bool
+}
+
+// AnalyzerOption configures an Analyzer built by NewAnalyzer.
+type AnalyzerOption func(*Analyzer)
+
+// WithStopwords adds custom stopwords on top of any language list already
+// configured.
+func WithStopwords(words []string) AnalyzerOption {
+	return func(a *Analyzer) {
+		for _, w := range words {
+			a.stopwords[strings.ToLower(w)] = true
+		}
+	}
+}
+
+// WithLanguageStopwords merges in one of the built-in stopword lists
+// ("english", "spanish", "german"). Unknown languages are a no-op.
+func WithLanguageStopwords(lang string) AnalyzerOption {
+	return func(a *Analyzer) {
+		for _, w := range stopwordsFor(lang) {
+			a.stopwords[w] = true
+		}
+	}
+}
+
+// WithMinRuneLength sets the minimum word length, in runes, to be counted.
+func WithMinRuneLength(n int) AnalyzerOption {
+	return func(a *Analyzer) { a.minRuneLen = n }
+}
+
+// WithCaseFolding overrides the default (lowercase) case-folding policy.
+func WithCaseFolding(f CaseFolding) AnalyzerOption {
+	return func(a *Analyzer) { a.fold = f }
+}
+
+// WithStemming enables collapsing inflected forms ("running", "runs") onto
+// a common stem before counting.
+func WithStemming(enabled bool) AnalyzerOption {
+	return func(a *Analyzer) { a.stem = enabled }
+}
+
+// NewAnalyzer builds an Analyzer with sane defaults (lowercase folding,
+// minimum 3-rune words, no stopwords, no stemming) modified by opts.
+func NewAnalyzer(opts ...AnalyzerOption) *Analyzer {
+	a := &Analyzer{
+		stopwords:  make(map[string]bool),
+		minRuneLen: 3,
+		fold:       FoldLower,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// normalize maps a raw word (already trimmed of surrounding punctuation and
+// whitespace) to the key it should be counted under, or "" if it should be
+// dropped.
+func (a *Analyzer) normalize(word string) string {
+	w := word
+	if a.fold == FoldLower {
+		w = strings.ToLower(w)
+	}
+	if utf8.RuneCountInString(w) < a.minRuneLen {
+		return ""
+	}
+	if a.stopwords[strings.ToLower(w)] {
+		return ""
+	}
+	if a.stem {
+		w = stem(w)
+	}
+	return w
+}
+
+// Aggregate re-keys a raw word-count map (as produced by the streaming
+// stats pass) through this Analyzer's policy, merging any counts that
+// collapse onto the same normalized word.
+func (a *Analyzer) Aggregate(counts map[string]int) map[string]int {
+	out := make(map[string]int, len(counts))
+	for word, count := range counts {
+		key := a.normalize(word)
+		if key == "" {
+			continue
+		}
+		out[key] += count
+	}
+	return out
+}
+
+// TopWords returns the limit most frequent surviving words, most frequent
+// first. limit <= 0 means "all of them".
+func (a *Analyzer) TopWords(counts map[string]int, limit int) []WordFreq {
+	return rankWords(byCountDesc(a.Aggregate(counts)), limit)
+}
+
+// AlphabeticalWords returns every surviving word sorted alphabetically.
+func (a *Analyzer) AlphabeticalWords(counts map[string]int) []WordFreq {
+	agg := a.Aggregate(counts)
+	keys := make([]string, 0, len(agg))
+	for k := range agg {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	words := make([]WordFreq, len(keys))
+	for i, k := range keys {
+		words[i] = WordFreq{Word: k, Count: agg[k], Rank: i + 1}
+	}
+	return words
+}
+
+// WordsAtLeast returns surviving words that appear at least minCount times,
+// most frequent first.
+func (a *Analyzer) WordsAtLeast(counts map[string]int, minCount int) []WordFreq {
+	agg := a.Aggregate(counts)
+	filtered := make(map[string]int, len(agg))
+	for w, c := range agg {
+		if c >= minCount {
+			filtered[w] = c
+		}
+	}
+	return rankWords(byCountDesc(filtered), 0)
+}
+
+// byCountDesc converts a count map to a []WordFreq sorted by count
+// descending (ties broken alphabetically), with Rank left unset.
+func byCountDesc(counts map[string]int) []WordFreq {
+	words := make([]WordFreq, 0, len(counts))
+	for w, c := range counts {
+		words = append(words, WordFreq{Word: w, Count: c})
+	}
+	sort.Slice(words, func(i, j int) bool {
+		if words[i].Count != words[j].Count {
+			return words[i].Count > words[j].Count
+		}
+		return words[i].Word < words[j].Word
+	})
+	return words
+}
+
+// rankWords truncates to limit (if positive) and stamps 1-based Rank.
+func rankWords(words []WordFreq, limit int) []WordFreq {
+	if limit > 0 && limit < len(words) {
+		words = words[:limit]
+	}
+	for i := range words {
+		words[i].Rank = i + 1
+	}
+	return words
+}
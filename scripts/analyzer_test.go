@@ -0,0 +1,120 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStem(t *testing.T) {
+	cases := []struct {
+		word string
+		want string
+	}{
+		{"running", "run"},
+		{"stopped", "stop"},
+		{"cats", "cat"},
+		{"boxes", "box"},
+		{"flies", "fly"},
+		{"ran", "run"},
+		{"went", "go"},
+		{"was", "be"},
+		{"cat", "cat"},
+	}
+
+	for _, c := range cases {
+		if got := stem(c.word); got != c.want {
+			t.Errorf("stem(%q) = %q, want %q", c.word, got, c.want)
+		}
+	}
+}
+
+func TestStopwordsFor(t *testing.T) {
+	if got := stopwordsFor("english"); len(got) == 0 {
+		t.Fatal("stopwordsFor(english) returned no words")
+	}
+	if got := stopwordsFor("EN"); len(got) == 0 {
+		t.Error("stopwordsFor is not case-insensitive for language codes")
+	}
+	if got := stopwordsFor("klingon"); got != nil {
+		t.Errorf("stopwordsFor(klingon) = %v, want nil", got)
+	}
+}
+
+func TestAnalyzerAggregateStemAndStopwords(t *testing.T) {
+	counts := map[string]int{
+		"Running": 3,
+		"runs":    2,
+		"the":     5,
+		"cats":    4,
+		"cat":     1,
+	}
+
+	a := NewAnalyzer(WithLanguageStopwords("english"), WithStemming(true))
+	got := a.TopWords(counts, 10)
+
+	want := []WordFreq{
+		{Word: "cat", Count: 5, Rank: 1},
+		{Word: "run", Count: 5, Rank: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TopWords = %+v, want %+v", got, want)
+	}
+}
+
+func TestAnalyzerCaseFoldingNone(t *testing.T) {
+	counts := map[string]int{"The": 1, "Cat": 2}
+
+	a := NewAnalyzer(WithCaseFolding(FoldNone), WithLanguageStopwords("english"))
+	got := a.TopWords(counts, 10)
+
+	// "The" is still recognized as a stopword (the lookup lowercases
+	// regardless of fold policy), but "Cat" keeps its original casing
+	// since FoldNone skips the lowercase step.
+	want := []WordFreq{{Word: "Cat", Count: 2, Rank: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TopWords = %+v, want %+v", got, want)
+	}
+}
+
+func TestAnalyzerWordsAtLeast(t *testing.T) {
+	counts := map[string]int{"apple": 3, "pear": 1, "plum": 2}
+
+	a := NewAnalyzer()
+	got := a.WordsAtLeast(counts, 2)
+
+	want := []WordFreq{
+		{Word: "apple", Count: 3, Rank: 1},
+		{Word: "plum", Count: 2, Rank: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WordsAtLeast = %+v, want %+v", got, want)
+	}
+}
+
+func TestAnalyzerAlphabeticalWords(t *testing.T) {
+	counts := map[string]int{"zebra": 1, "apple": 1, "mango": 1}
+
+	a := NewAnalyzer()
+	got := a.AlphabeticalWords(counts)
+
+	want := []WordFreq{
+		{Word: "apple", Count: 1, Rank: 1},
+		{Word: "mango", Count: 1, Rank: 2},
+		{Word: "zebra", Count: 1, Rank: 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AlphabeticalWords = %+v, want %+v", got, want)
+	}
+}
+
+func TestAnalyzerMinRuneLengthDropsShortWords(t *testing.T) {
+	counts := map[string]int{"a": 5, "an": 5, "and": 5}
+
+	a := NewAnalyzer(WithMinRuneLength(3))
+	got := a.TopWords(counts, 10)
+
+	want := []WordFreq{{Word: "and", Count: 5, Rank: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TopWords = %+v, want %+v", got, want)
+	}
+}
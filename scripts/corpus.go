@@ -0,0 +1,200 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Corpus holds a named set of documents for comparative analysis: n-grams,
+// PMI-based collocations, and TF-IDF, as opposed to AnalyzeText's
+// single-document stats.
+type Corpus struct {
+	docs  map[string][]string // doc name -> cleaned, lowercased tokens in order
+	order []string            // doc names in insertion order
+}
+
+// NewCorpus returns an empty Corpus.
+func NewCorpus() *Corpus {
+	return &Corpus{docs: make(map[string][]string)}
+}
+
+// Add tokenizes text the same way the single-document analysis does
+// (scanWords, lowercased, punctuation-trimmed) and stores it under name,
+// overwriting any previous document with that name.
+func (c *Corpus) Add(name, text string) {
+	raw := scanWords(text)
+	words := make([]string, 0, len(raw))
+	for _, w := range raw {
+		clean := strings.ToLower(strings.TrimFunc(w, func(r rune) bool {
+			return unicode.IsPunct(r) || unicode.IsSpace(r)
+		}))
+		if clean != "" {
+			words = append(words, clean)
+		}
+	}
+
+	if _, exists := c.docs[name]; !exists {
+		c.order = append(c.order, name)
+	}
+	c.docs[name] = words
+}
+
+// NGram is a run of n words and how often it occurs across the corpus.
+type NGram struct {
+	Words []string
+	Count int
+}
+
+// TopNGrams returns the k most frequent n-word sequences across every
+// document in the corpus, most frequent first. k <= 0 means "all of them".
+func (c *Corpus) TopNGrams(n, k int) []NGram {
+	if n < 1 {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	grams := make(map[string][]string)
+	for _, words := range c.docs {
+		for i := 0; i+n <= len(words); i++ {
+			gram := words[i : i+n]
+			key := strings.Join(gram, " ")
+			if _, seen := grams[key]; !seen {
+				grams[key] = append([]string(nil), gram...)
+			}
+			counts[key]++
+		}
+	}
+
+	result := make([]NGram, 0, len(counts))
+	for key, count := range counts {
+		result = append(result, NGram{Words: grams[key], Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return strings.Join(result[i].Words, " ") < strings.Join(result[j].Words, " ")
+	})
+
+	if k > 0 && k < len(result) {
+		result = result[:k]
+	}
+	return result
+}
+
+// Collocation is a two-word sequence with its corpus-wide count and
+// pointwise mutual information.
+type Collocation struct {
+	Words [2]string
+	Count int
+	PMI   float64
+}
+
+// Collocations finds adjacent word pairs that co-occur more often than
+// chance would predict: PMI = log2(P(x,y) / (P(x)P(y))), keeping only pairs
+// with at least minCount joint occurrences (to avoid hapax noise) and PMI at
+// or above minPMI. Results are sorted by descending PMI.
+func (c *Corpus) Collocations(minCount int, minPMI float64) []Collocation {
+	unigramCounts := make(map[string]int)
+	bigramCounts := make(map[[2]string]int)
+	totalWords := 0
+	totalBigrams := 0
+
+	for _, words := range c.docs {
+		for i, w := range words {
+			unigramCounts[w]++
+			totalWords++
+			if i+1 < len(words) {
+				bigramCounts[[2]string{w, words[i+1]}]++
+				totalBigrams++
+			}
+		}
+	}
+
+	if totalWords == 0 || totalBigrams == 0 {
+		return nil
+	}
+
+	var result []Collocation
+	for pair, count := range bigramCounts {
+		if count < minCount {
+			continue
+		}
+		px := float64(unigramCounts[pair[0]]) / float64(totalWords)
+		py := float64(unigramCounts[pair[1]]) / float64(totalWords)
+		pxy := float64(count) / float64(totalBigrams)
+		pmi := math.Log2(pxy / (px * py))
+		if pmi < minPMI {
+			continue
+		}
+		result = append(result, Collocation{Words: pair, Count: count, PMI: pmi})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].PMI != result[j].PMI {
+			return result[i].PMI > result[j].PMI
+		}
+		return result[i].Words[0]+" "+result[i].Words[1] < result[j].Words[0]+" "+result[j].Words[1]
+	})
+	return result
+}
+
+// TermScore is a single term's TF-IDF weight within one document.
+type TermScore struct {
+	Term  string
+	Score float64
+}
+
+// TopTFIDF scores every term in doc by TF-IDF against the rest of the
+// corpus (TF = count/docLen, IDF = log((1+N)/(1+df)) + 1, the standard
+// smoothed form) and returns the k highest-scoring terms, most relevant
+// first. k <= 0 means "all of them".
+func (c *Corpus) TopTFIDF(doc string, k int) []TermScore {
+	words, ok := c.docs[doc]
+	if !ok || len(words) == 0 {
+		return nil
+	}
+
+	termFreq := make(map[string]int)
+	for _, w := range words {
+		termFreq[w]++
+	}
+
+	n := float64(len(c.docs))
+	scores := make([]TermScore, 0, len(termFreq))
+	for term, count := range termFreq {
+		tf := float64(count) / float64(len(words))
+		df := float64(c.documentFrequency(term))
+		idf := math.Log((1+n)/(1+df)) + 1
+		scores = append(scores, TermScore{Term: term, Score: tf * idf})
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].Term < scores[j].Term
+	})
+
+	if k > 0 && k < len(scores) {
+		scores = scores[:k]
+	}
+	return scores
+}
+
+// documentFrequency counts how many documents in the corpus contain term
+// at least once.
+func (c *Corpus) documentFrequency(term string) int {
+	count := 0
+	for _, words := range c.docs {
+		for _, w := range words {
+			if w == term {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func buildTestCorpus() *Corpus {
+	c := NewCorpus()
+	c.Add("doc1", "the cat sat on the mat")
+	c.Add("doc2", "the dog sat on the log")
+	return c
+}
+
+func TestCorpusTopNGrams(t *testing.T) {
+	c := buildTestCorpus()
+
+	got := c.TopNGrams(2, 2)
+	want := []NGram{
+		{Words: []string{"on", "the"}, Count: 2},
+		{Words: []string{"sat", "on"}, Count: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TopNGrams(2, 2) = %+v, want %+v", got, want)
+	}
+}
+
+func TestCorpusCollocations(t *testing.T) {
+	c := buildTestCorpus()
+
+	got := c.Collocations(2, -100)
+	if len(got) != 2 {
+		t.Fatalf("Collocations returned %d pairs, want 2: %+v", len(got), got)
+	}
+
+	// "sat on" (the,the jointly-predictable pair of rarer words) has higher
+	// PMI than "on the" (which involves "the", the most common word), so it
+	// sorts first.
+	if got[0].Words != [2]string{"sat", "on"} {
+		t.Errorf("got[0].Words = %v, want [sat on]", got[0].Words)
+	}
+	if got[1].Words != [2]string{"on", "the"} {
+		t.Errorf("got[1].Words = %v, want [on the]", got[1].Words)
+	}
+	if got[0].PMI <= got[1].PMI {
+		t.Errorf("expected got[0].PMI (%v) > got[1].PMI (%v)", got[0].PMI, got[1].PMI)
+	}
+	if !almostEqual(got[0].PMI, 2.848, 0.01) {
+		t.Errorf("PMI(sat, on) = %v, want ~2.848", got[0].PMI)
+	}
+	if !almostEqual(got[1].PMI, 1.848, 0.01) {
+		t.Errorf("PMI(on, the) = %v, want ~1.848", got[1].PMI)
+	}
+}
+
+func TestCorpusCollocationsEmpty(t *testing.T) {
+	c := NewCorpus()
+	if got := c.Collocations(1, 0); got != nil {
+		t.Errorf("Collocations on empty corpus = %v, want nil", got)
+	}
+}
+
+func TestCorpusTopTFIDF(t *testing.T) {
+	c := buildTestCorpus()
+
+	got := c.TopTFIDF("doc1", 3)
+	want := []string{"the", "cat", "mat"}
+
+	if len(got) != len(want) {
+		t.Fatalf("TopTFIDF returned %d terms, want %d: %+v", len(got), len(want), got)
+	}
+	for i, term := range want {
+		if got[i].Term != term {
+			t.Errorf("got[%d].Term = %q, want %q", i, got[i].Term, term)
+		}
+	}
+	if !almostEqual(got[0].Score, 0.3333, 0.001) {
+		t.Errorf("score(the) = %v, want ~0.3333", got[0].Score)
+	}
+	if !almostEqual(got[1].Score, 0.2342, 0.001) {
+		t.Errorf("score(cat) = %v, want ~0.2342", got[1].Score)
+	}
+}
+
+func TestCorpusTopTFIDFUnknownDoc(t *testing.T) {
+	c := buildTestCorpus()
+	if got := c.TopTFIDF("missing", 0); got != nil {
+		t.Errorf("TopTFIDF(missing) = %v, want nil", got)
+	}
+}
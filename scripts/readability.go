@@ -0,0 +1,104 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// ReadabilityScores collects the standard battery of readability metrics
+// computed from a single TextStats pass. JSON tags match statRows's
+// snake_case machine names, keeping the JSON reporter in step with its
+// CSV/Markdown/ANSI siblings.
+type ReadabilityScores struct {
+	FleschReadingEase  float64 `json:"flesch_reading_ease"`
+	FleschKincaidGrade float64 `json:"flesch_kincaid_grade"`
+	GunningFog         float64 `json:"gunning_fog"`
+	SMOG               float64 `json:"smog"`
+	ColemanLiau        float64 `json:"coleman_liau"`
+	ARI                float64 `json:"automated_readability_index"`
+}
+
+// Score computes Flesch Reading Ease, Flesch-Kincaid Grade Level, Gunning
+// Fog, SMOG, Coleman-Liau, and the Automated Readability Index from stats.
+// Each formula is the standard published one; stats.Words/Sentences are
+// floored at 1 so a near-empty text yields a score instead of dividing by
+// zero.
+func Score(stats *TextStats) ReadabilityScores {
+	words := float64(stats.Words)
+	if words < 1 {
+		words = 1
+	}
+	sentences := float64(stats.Sentences)
+	if sentences < 1 {
+		sentences = 1
+	}
+	syllables := float64(stats.Syllables)
+	letters := float64(stats.Letters)
+
+	wordsPerSentence := words / sentences
+	syllablesPerWord := syllables / words
+	lettersPer100Words := letters / words * 100
+	sentencesPer100Words := sentences / words * 100
+
+	return ReadabilityScores{
+		FleschReadingEase:  206.835 - 1.015*wordsPerSentence - 84.6*syllablesPerWord,
+		FleschKincaidGrade: 0.39*wordsPerSentence + 11.8*syllablesPerWord - 15.59,
+		GunningFog:         0.4 * (wordsPerSentence + 100*float64(stats.ComplexWords)/words),
+		SMOG:               1.0430*math.Sqrt(float64(stats.PolysyllableWords)*(30/sentences)) + 3.1291,
+		ColemanLiau:        0.0588*lettersPer100Words - 0.296*sentencesPer100Words - 15.8,
+		ARI:                4.71*(letters/words) + 0.5*wordsPerSentence - 21.43,
+	}
+}
+
+// countSyllables estimates a word's syllable count with the classic
+// vowel-group heuristic: lowercase, drop a trailing silent 'e', then count
+// maximal runs of [aeiouy]. Every word has at least one syllable.
+func countSyllables(word string) int {
+	var letters strings.Builder
+	for _, r := range strings.ToLower(word) {
+		if unicode.IsLetter(r) {
+			letters.WriteRune(r)
+		}
+	}
+	cleaned := strings.TrimSuffix(letters.String(), "e")
+	if cleaned == "" {
+		return 1
+	}
+
+	count := 0
+	prevVowel := false
+	for _, r := range cleaned {
+		isVowel := strings.ContainsRune("aeiouy", r)
+		if isVowel && !prevVowel {
+			count++
+		}
+		prevVowel = isVowel
+	}
+	if count == 0 {
+		count = 1
+	}
+	return count
+}
+
+// complexSuffixes are inflections that Gunning Fog's complex-word count
+// excludes: a word isn't "complex" just because a plural/past-tense/gerund
+// suffix pushed it to 3 syllables.
+var complexSuffixes = []string{"es", "ed", "ing"}
+
+// isComplexWord reports whether word counts as a Gunning Fog complex word:
+// 3+ syllables that survive stripping a trailing inflectional suffix.
+func isComplexWord(word string, syllables int) bool {
+	if syllables < 3 {
+		return false
+	}
+	lower := strings.ToLower(word)
+	for _, suffix := range complexSuffixes {
+		if stripped := strings.TrimSuffix(lower, suffix); stripped != lower {
+			if countSyllables(stripped) < 3 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b, eps float64) bool {
+	return math.Abs(a-b) < eps
+}
+
+func TestCountSyllables(t *testing.T) {
+	cases := []struct {
+		word string
+		want int
+	}{
+		{"cat", 1},
+		{"the", 1},
+		{"apple", 1},
+		{"queue", 1},
+		{"hello", 2},
+		{"beautiful", 3},
+	}
+
+	for _, c := range cases {
+		if got := countSyllables(c.word); got != c.want {
+			t.Errorf("countSyllables(%q) = %d, want %d", c.word, got, c.want)
+		}
+	}
+}
+
+func TestIsComplexWord(t *testing.T) {
+	cases := []struct {
+		name      string
+		word      string
+		syllables int
+		want      bool
+	}{
+		{"below threshold", "cat", 2, false},
+		{"plural suffix drops below 3", "boxes", 3, false},
+		{"past-tense suffix drops below 3", "wanted", 3, false},
+		{"gerund suffix drops below 3", "running", 3, false},
+		{"no stripped suffix, stays complex", "wonderful", 3, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isComplexWord(c.word, c.syllables); got != c.want {
+				t.Errorf("isComplexWord(%q, %d) = %v, want %v", c.word, c.syllables, got, c.want)
+			}
+		})
+	}
+}
+
+func TestScoreFormulas(t *testing.T) {
+	stats := &TextStats{
+		Words:             10,
+		Sentences:         2,
+		Syllables:         15,
+		Letters:           40,
+		ComplexWords:      3,
+		PolysyllableWords: 2,
+	}
+
+	got := Score(stats)
+	want := ReadabilityScores{
+		FleschReadingEase:  74.86,
+		FleschKincaidGrade: 4.06,
+		GunningFog:         14.0,
+		SMOG:               8.84,
+		ColemanLiau:        1.8,
+		ARI:                -0.09,
+	}
+
+	eps := 0.01
+	if !almostEqual(got.FleschReadingEase, want.FleschReadingEase, eps) {
+		t.Errorf("FleschReadingEase = %v, want %v", got.FleschReadingEase, want.FleschReadingEase)
+	}
+	if !almostEqual(got.FleschKincaidGrade, want.FleschKincaidGrade, eps) {
+		t.Errorf("FleschKincaidGrade = %v, want %v", got.FleschKincaidGrade, want.FleschKincaidGrade)
+	}
+	if !almostEqual(got.GunningFog, want.GunningFog, eps) {
+		t.Errorf("GunningFog = %v, want %v", got.GunningFog, want.GunningFog)
+	}
+	if !almostEqual(got.SMOG, want.SMOG, eps) {
+		t.Errorf("SMOG = %v, want %v", got.SMOG, want.SMOG)
+	}
+	if !almostEqual(got.ColemanLiau, want.ColemanLiau, eps) {
+		t.Errorf("ColemanLiau = %v, want %v", got.ColemanLiau, want.ColemanLiau)
+	}
+	if !almostEqual(got.ARI, want.ARI, eps) {
+		t.Errorf("ARI = %v, want %v", got.ARI, want.ARI)
+	}
+}
+
+func TestScoreFloorsWordsAndSentencesAtOne(t *testing.T) {
+	// An empty TextStats has Words == Sentences == 0; Score must not divide
+	// by zero, instead treating both as 1.
+	got := Score(&TextStats{})
+	want := Score(&TextStats{Words: 1, Sentences: 1})
+	if got != want {
+		t.Errorf("Score(empty) = %+v, want %+v (same as Words=Sentences=1)", got, want)
+	}
+}
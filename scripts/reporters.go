@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// reportWordLimit caps how many ranked words a Reporter is handed; main
+// builds the []WordFreq via Analyzer.TopWords(freq, reportWordLimit).
+const reportWordLimit = 10
+
+// Reporter renders a finished analysis as bytes in some output format.
+type Reporter interface {
+	Render(stats *TextStats, freq []WordFreq) ([]byte, error)
+}
+
+// reporterFor resolves the -format flag to a Reporter. An empty string
+// selects the plain-text reporter.
+func reporterFor(format string) (Reporter, error) {
+	switch strings.ToLower(format) {
+	case "", "text":
+		return TextReporter{}, nil
+	case "json":
+		return JSONReporter{}, nil
+	case "csv":
+		return CSVReporter{}, nil
+	case "md", "markdown":
+		return MarkdownReporter{}, nil
+	case "ansi":
+		return ANSIReporter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown report format %q", format)
+	}
+}
+
+// statRows lists every stat and readability metric as ordered
+// (machine_name, formatted value) pairs, shared by the CSV, Markdown, and
+// ANSI reporters so the three stay in sync.
+func statRows(stats *TextStats) [][2]string {
+	scores := Score(stats)
+	return [][2]string{
+		{"total_characters", strconv.Itoa(stats.TotalChars)},
+		{"letters", strconv.Itoa(stats.Letters)},
+		{"digits", strconv.Itoa(stats.Digits)},
+		{"spaces", strconv.Itoa(stats.Spaces)},
+		{"punctuation", strconv.Itoa(stats.Punctuation)},
+		{"words", strconv.Itoa(stats.Words)},
+		{"unique_words", strconv.Itoa(stats.UniqueWords)},
+		{"sentences", strconv.Itoa(stats.Sentences)},
+		{"lines", strconv.Itoa(stats.Lines)},
+		{"paragraphs", strconv.Itoa(stats.Paragraphs)},
+		{"avg_word_length", fmt.Sprintf("%.2f", stats.AvgWordLength)},
+		{"avg_words_per_line", fmt.Sprintf("%.2f", stats.AvgWordsPerLine)},
+		{"flesch_reading_ease", fmt.Sprintf("%.2f", scores.FleschReadingEase)},
+		{"flesch_kincaid_grade", fmt.Sprintf("%.2f", scores.FleschKincaidGrade)},
+		{"gunning_fog", fmt.Sprintf("%.2f", scores.GunningFog)},
+		{"smog", fmt.Sprintf("%.2f", scores.SMOG)},
+		{"coleman_liau", fmt.Sprintf("%.2f", scores.ColemanLiau)},
+		{"automated_readability_index", fmt.Sprintf("%.2f", scores.ARI)},
+	}
+}
+
+// TextReporter is the original fixed plaintext block, now routed through
+// the Reporter interface.
+type TextReporter struct{}
+
+func (TextReporter) Render(stats *TextStats, freq []WordFreq) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString(GenerateReport(stats))
+	if len(freq) > 0 {
+		b.WriteString("\nMost Frequent Words:\n")
+		for _, w := range freq {
+			b.WriteString(fmt.Sprintf("  %d. %s (%d)\n", w.Rank, w.Word, w.Count))
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+// JSONReporter renders stats, readability scores, and word frequencies as
+// a single indented JSON document, for machine consumption or piping.
+type JSONReporter struct{}
+
+type jsonReport struct {
+	Stats           *TextStats        `json:"stats"`
+	Readability     ReadabilityScores `json:"readability"`
+	WordFrequencies []WordFreq        `json:"word_frequencies,omitempty"`
+}
+
+func (JSONReporter) Render(stats *TextStats, freq []WordFreq) ([]byte, error) {
+	data, err := json.MarshalIndent(jsonReport{
+		Stats:           stats,
+		Readability:     Score(stats),
+		WordFrequencies: freq,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// CSVReporter renders one row per metric. Word frequencies don't fit that
+// shape, so they get their own table via RenderWordFrequencies, which main
+// writes to a sibling "wordfreq.csv" file.
+type CSVReporter struct{}
+
+func (CSVReporter) Render(stats *TextStats, freq []WordFreq) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"metric", "value"})
+	for _, row := range statRows(stats) {
+		w.Write(row[:])
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func (CSVReporter) RenderWordFrequencies(freq []WordFreq) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"rank", "word", "count"})
+	for _, f := range freq {
+		w.Write([]string{strconv.Itoa(f.Rank), f.Word, strconv.Itoa(f.Count)})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// MarkdownReporter renders a GitHub-flavored Markdown document with one
+// table for metrics and one for word frequencies.
+type MarkdownReporter struct{}
+
+func (MarkdownReporter) Render(stats *TextStats, freq []WordFreq) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("# Text Analysis Report\n\n")
+	b.WriteString("| Metric | Value |\n|---|---|\n")
+	for _, row := range statRows(stats) {
+		b.WriteString(fmt.Sprintf("| %s | %s |\n", row[0], row[1]))
+	}
+
+	if len(freq) > 0 {
+		b.WriteString("\n## Most Frequent Words\n\n| Rank | Word | Count |\n|---|---|---|\n")
+		for _, f := range freq {
+			b.WriteString(fmt.Sprintf("| %d | %s | %d |\n", f.Rank, f.Word, f.Count))
+		}
+	}
+
+	return []byte(b.String()), nil
+}
+
+// ANSI escape codes used by ANSIReporter: bold headings, inverted section
+// banners, faint units.
+const (
+	ansiReset   = "\x1b[0m"
+	ansiBold    = "\x1b[1m"
+	ansiFaint   = "\x1b[2m"
+	ansiInverse = "\x1b[7m"
+)
+
+// ANSIReporter renders the same metrics as MarkdownReporter/CSVReporter as
+// a colored terminal report: inverted banners for sections, bold metric
+// names, faint values.
+type ANSIReporter struct{}
+
+func (ANSIReporter) Render(stats *TextStats, freq []WordFreq) ([]byte, error) {
+	var b strings.Builder
+
+	banner := func(title string) {
+		b.WriteString(fmt.Sprintf("%s %s %s\n", ansiInverse, title, ansiReset))
+	}
+
+	banner("Text Analysis Report")
+	for _, row := range statRows(stats) {
+		b.WriteString(fmt.Sprintf("%s%s:%s %s%s%s\n", ansiBold, row[0], ansiReset, ansiFaint, row[1], ansiReset))
+	}
+
+	if len(freq) > 0 {
+		banner("Most Frequent Words")
+		for _, f := range freq {
+			b.WriteString(fmt.Sprintf("  %s%d.%s %s %s(%d)%s\n", ansiBold, f.Rank, ansiReset, f.Word, ansiFaint, f.Count, ansiReset))
+		}
+	}
+
+	return []byte(b.String()), nil
+}
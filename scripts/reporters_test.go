@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// goldenFixture builds the same TextStats/word-frequency pair every golden
+// file under testdata/ was generated from: "Cat sat. Dog ran." (two short,
+// single-syllable sentences, so the readability math stays easy to check
+// by hand).
+func goldenFixture(t *testing.T) (*TextStats, []WordFreq) {
+	t.Helper()
+
+	stats := &TextStats{}
+	freq := make(map[string]int)
+	if err := ReadAndUpdateStats(strings.NewReader("Cat sat. Dog ran."), stats, freq); err != nil {
+		t.Fatalf("ReadAndUpdateStats: %v", err)
+	}
+
+	analyzer := NewAnalyzer(WithMinRuneLength(3))
+	return stats, analyzer.TopWords(freq, 10)
+}
+
+func readGolden(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("reading golden file %s: %v", name, err)
+	}
+	return data
+}
+
+func TestReportersGolden(t *testing.T) {
+	stats, freq := goldenFixture(t)
+
+	cases := []struct {
+		name     string
+		reporter Reporter
+		golden   string
+	}{
+		{"text", TextReporter{}, "report.text.golden"},
+		{"json", JSONReporter{}, "report.json.golden"},
+		{"csv", CSVReporter{}, "report.csv.golden"},
+		{"markdown", MarkdownReporter{}, "report.md.golden"},
+		{"ansi", ANSIReporter{}, "report.ansi.golden"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := c.reporter.Render(stats, freq)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			want := readGolden(t, c.golden)
+			if string(got) != string(want) {
+				t.Errorf("%s reporter output mismatch\ngot:\n%s\nwant:\n%s", c.name, got, want)
+			}
+		})
+	}
+}
+
+func TestCSVReporterWordFrequencies(t *testing.T) {
+	_, freq := goldenFixture(t)
+
+	got, err := CSVReporter{}.RenderWordFrequencies(freq)
+	if err != nil {
+		t.Fatalf("RenderWordFrequencies: %v", err)
+	}
+	want := readGolden(t, "wordfreq.csv.golden")
+	if string(got) != string(want) {
+		t.Errorf("word frequency CSV mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import "strings"
+
+// irregularStems hand-codes the common irregular verb forms a suffix-based
+// stemmer can never derive ("ran" -> "run").
+var irregularStems = map[string]string{
+	"ran":  "run",
+	"went": "go",
+	"was":  "be",
+	"were": "be",
+}
+
+// stem reduces a lowercase word to an approximate root, collapsing common
+// inflections ("running", "runs" -> "run") the way a Porter2/Snowball
+// stemmer would. This is a simplified suffix-stripping pass, not a full
+// Snowball implementation, plus a small irregular-verb exception table.
+func stem(word string) string {
+	if root, ok := irregularStems[word]; ok {
+		return root
+	}
+
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "ing") && len(word) > 5:
+		return undouble(strings.TrimSuffix(word, "ing"))
+	case strings.HasSuffix(word, "ed") && len(word) > 4:
+		return undouble(strings.TrimSuffix(word, "ed"))
+	case strings.HasSuffix(word, "es") && len(word) > 4:
+		return strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && len(word) > 3:
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+// undouble removes a doubled trailing consonant left behind by stripping
+// "-ing"/"-ed" ("running" -> "runn" -> "run", "stopped" -> "stopp" -> "stop").
+func undouble(word string) string {
+	n := len(word)
+	if n < 2 {
+		return word
+	}
+	last, prev := word[n-1], word[n-2]
+	if last == prev && strings.IndexByte("aeiou", last) == -1 {
+		return word[:n-1]
+	}
+	return word
+}
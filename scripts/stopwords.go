@@ -0,0 +1,42 @@
+package main
+
+import "strings"
+
+// Built-in stopword lists, keyed by language name or ISO 639-1 code.
+// Lists are intentionally short — the common function words that would
+// otherwise dominate any frequency table — not exhaustive linguistic data.
+var (
+	englishStopwords = []string{
+		"a", "an", "and", "are", "as", "at", "be", "by", "for", "from",
+		"has", "he", "in", "is", "it", "its", "of", "on", "that", "the",
+		"to", "was", "were", "will", "with", "this", "but", "they", "have",
+		"had", "not", "or", "you", "we", "i",
+	}
+
+	spanishStopwords = []string{
+		"el", "la", "los", "las", "un", "una", "unos", "unas", "de", "del",
+		"en", "y", "o", "a", "que", "es", "con", "por", "para", "su", "se",
+		"no", "lo", "como", "pero", "mas", "este", "esta",
+	}
+
+	germanStopwords = []string{
+		"der", "die", "das", "den", "dem", "des", "ein", "eine", "einer",
+		"eines", "und", "oder", "in", "im", "zu", "zur", "zum", "von",
+		"mit", "ist", "sind", "nicht", "auf", "fur", "auch", "als",
+	}
+)
+
+// stopwordsFor returns the built-in stopword list for a language name or
+// ISO 639-1 code (case-insensitive). Unknown languages return nil.
+func stopwordsFor(lang string) []string {
+	switch strings.ToLower(lang) {
+	case "english", "en":
+		return englishStopwords
+	case "spanish", "es":
+		return spanishStopwords
+	case "german", "de":
+		return germanStopwords
+	default:
+		return nil
+	}
+}
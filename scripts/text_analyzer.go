@@ -1,40 +1,95 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"unicode"
-	"sort"
+	"unicode/utf8"
 )
 
-// TextStats holds statistics about text
+// TextStats holds statistics about text. JSON tags use the same snake_case
+// machine names as statRows, so the JSON reporter doesn't diverge from its
+// CSV/Markdown/ANSI siblings.
 type TextStats struct {
-	TotalChars     int
-	Letters        int
-	Digits         int
-	Spaces         int
-	Punctuation    int
-	Words          int
-	Lines          int
-	Sentences      int
-	UniqueWords    int
-	Paragraphs     int
-	AvgWordLength  float64
-	AvgWordsPerLine float64
+	TotalChars      int     `json:"total_characters"`
+	Letters         int     `json:"letters"`
+	Digits          int     `json:"digits"`
+	Spaces          int     `json:"spaces"`
+	Punctuation     int     `json:"punctuation"`
+	Words           int     `json:"words"`
+	Lines           int     `json:"lines"`
+	Sentences       int     `json:"sentences"`
+	UniqueWords     int     `json:"unique_words"`
+	Paragraphs      int     `json:"paragraphs"`
+	AvgWordLength   float64 `json:"avg_word_length"`
+	AvgWordsPerLine float64 `json:"avg_words_per_line"`
+
+	Syllables         int `json:"syllables"`
+	PolysyllableWords int `json:"polysyllable_words"`
+	ComplexWords      int `json:"complex_words"`
+
+	totalWordLength int
+	inParagraph     bool
 }
 
-// AnalyzeText performs comprehensive text analysis
-func AnalyzeText(text string) *TextStats {
-	stats := &TextStats{}
+// ReadAndUpdateStats streams r line by line, folding its contents into stats
+// and freq without ever holding the whole input in memory. It can be called
+// repeatedly against the same stats/freq pair to aggregate multiple sources.
+func ReadAndUpdateStats(r io.Reader, stats *TextStats, freq map[string]int) error {
+	reader := bufio.NewReader(r)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if line == "" && err != nil {
+			break
+		}
 
-	// Count basic characters
-	stats.TotalChars = len(text)
-	stats.Lines = strings.Count(text, "\n") + 1
-	stats.Paragraphs = strings.Count(text, "\n\n") + 1
+		hasNewline := strings.HasSuffix(line, "\n")
+		content := strings.TrimSuffix(line, "\n")
+		updateStatsForLine(content, stats, freq)
+		if hasNewline {
+			stats.TotalChars++ // the newline itself, trimmed off content above
+		}
+
+		if err != nil {
+			if err != io.EOF {
+				return err
+			}
+			break
+		}
+	}
 
-	// Count character types
-	for _, r := range text {
+	if stats.Words > 0 {
+		stats.AvgWordLength = float64(stats.totalWordLength) / float64(stats.Words)
+	}
+	if stats.Lines > 0 {
+		stats.AvgWordsPerLine = float64(stats.Words) / float64(stats.Lines)
+	}
+
+	return nil
+}
+
+// updateStatsForLine folds a single line (without its trailing newline) into
+// stats and freq. All counts are rune-correct rather than byte-correct, so
+// multi-byte text (Cyrillic, Hangul, CJK, ...) is measured the same way a
+// reader would count it.
+func updateStatsForLine(line string, stats *TextStats, freq map[string]int) {
+	stats.TotalChars += utf8.RuneCountInString(line)
+	stats.Lines++
+
+	if strings.TrimSpace(line) == "" {
+		stats.inParagraph = false
+	} else if !stats.inParagraph {
+		stats.Paragraphs++
+		stats.inParagraph = true
+	}
+
+	for _, r := range line {
 		if unicode.IsLetter(r) {
 			stats.Letters++
 		} else if unicode.IsDigit(r) {
@@ -46,40 +101,113 @@ func AnalyzeText(text string) *TextStats {
 		}
 	}
 
-	// Count words
-	words := strings.Fields(text)
-	stats.Words = len(words)
+	// Sentence boundaries are judged per line, the same granularity the
+	// streaming reader already folds stats in at.
+	stats.Sentences += countSentences(line)
 
-	// Count sentences
-	stats.Sentences = strings.Count(text, ".") +
-		strings.Count(text, "!") +
-		strings.Count(text, "?")
-
-	// Calculate unique words
-	wordMap := make(map[string]bool)
+	words := scanWords(line)
+	stats.Words += len(words)
 	for _, word := range words {
+		stats.totalWordLength += utf8.RuneCountInString(word)
+
 		cleanWord := strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
 			return unicode.IsPunct(r) || unicode.IsSpace(r)
 		}))
-		if len(cleanWord) > 0 {
-			wordMap[cleanWord] = true
+		if cleanWord == "" {
+			continue
+		}
+		if _, seen := freq[cleanWord]; !seen {
+			stats.UniqueWords++
+		}
+		freq[cleanWord]++
+
+		syl := countSyllables(cleanWord)
+		stats.Syllables += syl
+		if syl >= 3 {
+			stats.PolysyllableWords++
+		}
+		if isComplexWord(cleanWord, syl) {
+			stats.ComplexWords++
 		}
 	}
-	stats.UniqueWords = len(wordMap)
+}
 
-	// Calculate averages
-	if stats.Words > 0 {
-		totalLength := 0
-		for _, word := range words {
-			totalLength += len(word)
+// scanWords splits line into whitespace-delimited words using a hand-written
+// rune scanner rather than strings.Fields, so callers that need rune offsets
+// (sentence detection, abbreviation lookback) share the same notion of
+// "word" as the stats pass.
+func scanWords(line string) []string {
+	var words []string
+	runes := []rune(line)
+	i := 0
+	for i < len(runes) {
+		for i < len(runes) && unicode.IsSpace(runes[i]) {
+			i++
+		}
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			i++
+		}
+		if i > start {
+			words = append(words, string(runes[start:i]))
 		}
-		stats.AvgWordLength = float64(totalLength) / float64(stats.Words)
 	}
+	return words
+}
 
-	if stats.Lines > 0 {
-		stats.AvgWordsPerLine = float64(stats.Words) / float64(stats.Lines)
+// sentenceAbbreviations lists short tokens whose trailing period does not
+// end a sentence ("Dr. Smith", "etc. and so on").
+var sentenceAbbreviations = map[string]bool{
+	"dr": true, "mr": true, "mrs": true, "ms": true, "jr": true, "sr": true,
+	"prof": true, "st": true, "vs": true, "etc": true, "mt": true,
+	"rev": true, "gen": true, "col": true, "capt": true, "sgt": true, "lt": true,
+}
+
+func isTerminalPunct(r rune) bool {
+	return r == '.' || r == '!' || r == '?' || r == '…'
+}
+
+// countSentences counts sentence-ending punctuation runs (so "..." and "?!"
+// each count once) that are followed by either end of line or whitespace and
+// an uppercase letter, skipping runs that merely close a known abbreviation.
+func countSentences(line string) int {
+	runes := []rune(line)
+	count := 0
+	for i := 0; i < len(runes); {
+		if !isTerminalPunct(runes[i]) {
+			i++
+			continue
+		}
+
+		runStart := i
+		for i < len(runes) && isTerminalPunct(runes[i]) {
+			i++
+		}
+
+		wordStart := runStart
+		for wordStart > 0 && !unicode.IsSpace(runes[wordStart-1]) && !isTerminalPunct(runes[wordStart-1]) {
+			wordStart--
+		}
+		if sentenceAbbreviations[strings.ToLower(string(runes[wordStart:runStart]))] {
+			continue
+		}
+
+		j := i
+		for j < len(runes) && unicode.IsSpace(runes[j]) {
+			j++
+		}
+		if j >= len(runes) || unicode.IsUpper(runes[j]) {
+			count++
+		}
 	}
+	return count
+}
 
+// AnalyzeText performs comprehensive text analysis over a single in-memory string
+func AnalyzeText(text string) *TextStats {
+	stats := &TextStats{}
+	freq := make(map[string]int)
+	ReadAndUpdateStats(strings.NewReader(text), stats, freq)
 	return stats
 }
 
@@ -103,86 +231,215 @@ func GenerateReport(stats *TextStats) string {
 	report.WriteString(fmt.Sprintf("Paragraphs: %d\n\n", stats.Paragraphs))
 
 	report.WriteString(fmt.Sprintf("Avg Word Length: %.2f\n", stats.AvgWordLength))
-	report.WriteString(fmt.Sprintf("Avg Words Per Line: %.2f\n", stats.AvgWordsPerLine))
+	report.WriteString(fmt.Sprintf("Avg Words Per Line: %.2f\n\n", stats.AvgWordsPerLine))
 
-	// Calculate reading difficulty
-	difficulty := CalculateReadingDifficulty(stats)
-	report.WriteString(fmt.Sprintf("Reading Difficulty: %s\n", difficulty))
+	scores := Score(stats)
+	report.WriteString("Readability\n")
+	report.WriteString(fmt.Sprintf("  Flesch Reading Ease:       %.2f\n", scores.FleschReadingEase))
+	report.WriteString(fmt.Sprintf("  Flesch-Kincaid Grade:      %.2f\n", scores.FleschKincaidGrade))
+	report.WriteString(fmt.Sprintf("  Gunning Fog:               %.2f\n", scores.GunningFog))
+	report.WriteString(fmt.Sprintf("  SMOG:                      %.2f\n", scores.SMOG))
+	report.WriteString(fmt.Sprintf("  Coleman-Liau:              %.2f\n", scores.ColemanLiau))
+	report.WriteString(fmt.Sprintf("  Automated Readability Index: %.2f\n", scores.ARI))
 
 	return report.String()
 }
 
-// CalculateReadingDifficulty estimates text complexity
-func CalculateReadingDifficulty(stats *TextStats) string {
-	if stats.AvgWordLength > 6 && stats.Sentences > 0 {
-		return "Advanced"
-	} else if stats.AvgWordLength > 4 {
-		return "Intermediate"
+// expandArg turns a single CLI argument into a list of file paths. On
+// platforms without shell-side glob expansion (namely Windows) it expands
+// wildcard patterns itself via filepath.Glob; elsewhere it is returned as-is.
+func expandArg(arg string) []string {
+	if runtime.GOOS != "windows" {
+		return []string{arg}
+	}
+	if !strings.ContainsAny(arg, "*?[") {
+		return []string{arg}
 	}
-	return "Basic"
+	matches, err := filepath.Glob(arg)
+	if err != nil || len(matches) == 0 {
+		return []string{arg}
+	}
+	return matches
 }
 
-// GetFrequentWords returns most common words
-func GetFrequentWords(text string, limit int) []string {
-	wordFreq := make(map[string]int)
-	words := strings.Fields(text)
+func main() {
+	var files []string
+	aggregate := false
+	useStdin := false
+	var inlineText string
 
-	for _, word := range words {
-		cleanWord := strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
-			return unicode.IsPunct(r)
-		}))
-		if len(cleanWord) > 2 {
-			wordFreq[cleanWord]++
+	args := os.Args[1:]
+	format := "text"
+
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; {
+		case arg == "-f":
+			if i+1 >= len(args) {
+				fmt.Println("Error: -f requires a file path")
+				os.Exit(1)
+			}
+			i++
+			files = append(files, expandArg(args[i])...)
+		case arg == "-":
+			useStdin = true
+		case arg == "-agg":
+			aggregate = true
+		case strings.HasPrefix(arg, "-format="):
+			format = strings.TrimPrefix(arg, "-format=")
+		default:
+			if inlineText == "" && len(files) == 0 && !useStdin {
+				inlineText = arg
+			} else {
+				files = append(files, expandArg(arg)...)
+			}
 		}
 	}
 
-	// Sort by frequency
-	type kv struct {
-		Key   string
-		Value int
+	if len(files) == 0 && !useStdin && inlineText == "" {
+		fmt.Println("Usage: text_analyzer ['<text>' | -f file ...] [-] [-agg] [-format=json|csv|md|text|ansi]")
+		os.Exit(1)
 	}
-	var sorted []kv
-	for k, v := range wordFreq {
-		sorted = append(sorted, kv{k, v})
+
+	reporter, err := reporterFor(format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
 	}
 
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].Value > sorted[j].Value
-	})
+	analyzer := NewAnalyzer(WithMinRuneLength(3))
+
+	if inlineText != "" {
+		if strings.TrimSpace(inlineText) == "" {
+			fmt.Println("Error: Input text cannot be empty")
+			os.Exit(1)
+		}
+		stats := &TextStats{}
+		freq := make(map[string]int)
+		ReadAndUpdateStats(strings.NewReader(inlineText), stats, freq)
+		renderAndPrint(reporter, stats, analyzer.TopWords(freq, reportWordLimit), "")
+		return
+	}
 
-	// Extract top N words
-	result := []string{}
-	for i := 0; i < limit && i < len(sorted); i++ {
-		result = append(result, fmt.Sprintf("%s (%d)", sorted[i].Key, sorted[i].Value))
+	var sources []string
+	if useStdin {
+		sources = append(sources, "-")
 	}
+	sources = append(sources, files...)
 
-	return result
+	aggStats := &TextStats{}
+	aggFreq := make(map[string]int)
+
+	for _, src := range sources {
+		stats := &TextStats{}
+		freq := make(map[string]int)
+
+		if err := analyzeSource(src, stats, freq); err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", src, err)
+			os.Exit(1)
+		}
+
+		if len(sources) > 1 {
+			fmt.Printf("=== %s ===\n", src)
+		}
+		renderAndPrint(reporter, stats, analyzer.TopWords(freq, reportWordLimit), src)
+		fmt.Println()
+
+		if aggregate {
+			mergeStats(aggStats, stats, aggFreq, freq)
+		}
+	}
+
+	if aggregate && len(sources) > 1 {
+		fmt.Println("=== Aggregate ===")
+		renderAndPrint(reporter, aggStats, analyzer.TopWords(aggFreq, reportWordLimit), "aggregate")
+	}
 }
 
-func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: text_analyzer '<text>'")
+// renderAndPrint renders stats/freq with reporter and writes the result to
+// stdout. For CSVReporter, which can't fit word frequencies into its
+// one-row-per-metric shape, it also writes a sibling word-frequency CSV
+// named after label, so a multi-source run doesn't have every source
+// clobber the same wordfreq.csv. label == "" means "the only report this
+// run produces" and keeps the original unscoped wordfreq.csv name.
+func renderAndPrint(reporter Reporter, stats *TextStats, freq []WordFreq, label string) {
+	data, err := reporter.Render(stats, freq)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error rendering report:", err)
 		os.Exit(1)
 	}
+	os.Stdout.Write(data)
 
-	text := os.Args[1]
+	if csvReporter, ok := reporter.(CSVReporter); ok {
+		wfData, err := csvReporter.RenderWordFrequencies(freq)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error rendering wordfreq.csv:", err)
+			return
+		}
+		name := wordFreqFileName(label)
+		if err := os.WriteFile(name, wfData, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, "Error writing", name+":", err)
+		}
+	}
+}
 
-	if strings.TrimSpace(text) == "" {
-		fmt.Println("Error: Input text cannot be empty")
-		os.Exit(1)
+// wordFreqFileName picks the sibling CSV name renderAndPrint writes word
+// frequencies to: the original unscoped "wordfreq.csv" when there's only
+// one report in the run, otherwise "<source>.wordfreq.csv" so per-source
+// runs don't overwrite each other's output.
+func wordFreqFileName(label string) string {
+	if label == "" {
+		return "wordfreq.csv"
 	}
+	if label == "-" {
+		return "stdin.wordfreq.csv"
+	}
+	return filepath.Base(label) + ".wordfreq.csv"
+}
 
-	// Analyze text
-	stats := AnalyzeText(text)
-	report := GenerateReport(stats)
-	fmt.Print(report)
+// mergeStats folds a per-source TextStats/freq pair into a running
+// aggregate, recomputing the derived averages and unique-word count.
+func mergeStats(into, from *TextStats, intoFreq, fromFreq map[string]int) {
+	into.TotalChars += from.TotalChars
+	into.Letters += from.Letters
+	into.Digits += from.Digits
+	into.Spaces += from.Spaces
+	into.Punctuation += from.Punctuation
+	into.Words += from.Words
+	into.Lines += from.Lines
+	into.Sentences += from.Sentences
+	into.Paragraphs += from.Paragraphs
+	into.Syllables += from.Syllables
+	into.PolysyllableWords += from.PolysyllableWords
+	into.ComplexWords += from.ComplexWords
+	into.totalWordLength += from.totalWordLength
 
-	// Show frequent words
-	frequentWords := GetFrequentWords(text, 5)
-	if len(frequentWords) > 0 {
-		fmt.Println("\nMost Frequent Words (>2 chars):")
-		for _, word := range frequentWords {
-			fmt.Printf("  %s\n", word)
+	for word, count := range fromFreq {
+		if _, seen := intoFreq[word]; !seen {
+			into.UniqueWords++
 		}
+		intoFreq[word] += count
+	}
+
+	if into.Words > 0 {
+		into.AvgWordLength = float64(into.totalWordLength) / float64(into.Words)
+	}
+	if into.Lines > 0 {
+		into.AvgWordsPerLine = float64(into.Words) / float64(into.Lines)
 	}
 }
+
+// analyzeSource opens a single source ("-" for stdin, otherwise a file path)
+// and streams it into stats/freq via ReadAndUpdateStats.
+func analyzeSource(src string, stats *TextStats, freq map[string]int) error {
+	if src == "-" {
+		return ReadAndUpdateStats(os.Stdin, stats, freq)
+	}
+
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return ReadAndUpdateStats(f, stats, freq)
+}
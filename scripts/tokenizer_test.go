@@ -0,0 +1,57 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestAnalyzeTextRuneCorrectCharCounts(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+	}{
+		{"cyrillic", "Привет, мир!"},
+		{"hangul", "안녕하세요, 세계!"},
+		{"cjk", "你好，世界！"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			stats := AnalyzeText(c.text)
+			want := utf8.RuneCountInString(c.text)
+			if stats.TotalChars != want {
+				t.Errorf("TotalChars = %d, want %d (rune count, not byte count %d)", stats.TotalChars, want, len(c.text))
+			}
+		})
+	}
+}
+
+func TestScanWordsMultiByte(t *testing.T) {
+	got := scanWords("Привет мир 你好 세계")
+	want := []string{"Привет", "мир", "你好", "세계"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("scanWords = %v, want %v", got, want)
+	}
+}
+
+func TestCountSentencesSkipsAbbreviations(t *testing.T) {
+	cases := []struct {
+		name string
+		line string
+		want int
+	}{
+		{"abbreviation then sentence", "Dr. Smith went home. He was tired.", 2},
+		{"ellipsis counts once", "Wait... What?", 2},
+		{"plain two sentences", "This is a test. This is another.", 2},
+		{"trailing abbreviation only", "Please see Prof. Lee", 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := countSentences(c.line); got != c.want {
+				t.Errorf("countSentences(%q) = %d, want %d", c.line, got, c.want)
+			}
+		})
+	}
+}